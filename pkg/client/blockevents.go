@@ -17,9 +17,10 @@ import (
 )
 
 type baseBlockEventsRequest struct {
-	client    *gatewayClient
-	signingID *signingIdentity
-	request   *common.Envelope
+	client     *gatewayClient
+	signingID  *signingIdentity
+	request    *common.Envelope
+	bufferSize int
 }
 
 // Bytes of the serialized block events request.
@@ -66,7 +67,9 @@ type FilteredBlockEventsRequest struct {
 	baseBlockEventsRequest
 }
 
-// Events returns a channel from which filtered block events can be read.
+// Events returns a channel from which filtered block events can be read. Events are delivered in order and are not
+// dropped if the consumer is slow to read them. The channel is closed when the context is cancelled or the event stream
+// fails.
 func (events *FilteredBlockEventsRequest) Events(ctx context.Context, opts ...grpc.CallOption) (<-chan *peer.FilteredBlock, error) {
 	if err := events.sign(); err != nil {
 		return nil, err
@@ -77,7 +80,7 @@ func (events *FilteredBlockEventsRequest) Events(ctx context.Context, opts ...gr
 		return nil, err
 	}
 
-	results := make(chan *peer.FilteredBlock)
+	results := make(chan *peer.FilteredBlock, events.bufferSize)
 	go func() {
 		defer close(results)
 
@@ -88,7 +91,11 @@ func (events *FilteredBlockEventsRequest) Events(ctx context.Context, opts ...gr
 				return
 			}
 
-			results <- result
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
 		}
 	}()
 
@@ -100,7 +107,8 @@ type BlockEventsRequest struct {
 	baseBlockEventsRequest
 }
 
-// Events returns a channel from which block events can be read.
+// Events returns a channel from which block events can be read. Events are delivered in order and are not dropped if
+// the consumer is slow to read them. The channel is closed when the context is cancelled or the event stream fails.
 func (events *BlockEventsRequest) Events(ctx context.Context, opts ...grpc.CallOption) (<-chan *common.Block, error) {
 	if err := events.sign(); err != nil {
 		return nil, err
@@ -111,7 +119,7 @@ func (events *BlockEventsRequest) Events(ctx context.Context, opts ...grpc.CallO
 		return nil, err
 	}
 
-	results := make(chan *common.Block)
+	results := make(chan *common.Block, events.bufferSize)
 	go func() {
 		defer close(results)
 
@@ -122,7 +130,11 @@ func (events *BlockEventsRequest) Events(ctx context.Context, opts ...grpc.CallO
 				return
 			}
 
-			results <- result
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
 		}
 	}()
 
@@ -134,7 +146,9 @@ type BlockAndPrivateDataEventsRequest struct {
 	baseBlockEventsRequest
 }
 
-// Events returns a channel from which block and private data events can be read.
+// Events returns a channel from which block and private data events can be read. Events are delivered in order and are
+// not dropped if the consumer is slow to read them. The channel is closed when the context is cancelled or the event
+// stream fails.
 func (events *BlockAndPrivateDataEventsRequest) Events(ctx context.Context, opts ...grpc.CallOption) (<-chan *peer.BlockAndPrivateData, error) {
 	if err := events.sign(); err != nil {
 		return nil, err
@@ -145,7 +159,7 @@ func (events *BlockAndPrivateDataEventsRequest) Events(ctx context.Context, opts
 		return nil, err
 	}
 
-	results := make(chan *peer.BlockAndPrivateData)
+	results := make(chan *peer.BlockAndPrivateData, events.bufferSize)
 	go func() {
 		defer close(results)
 
@@ -156,7 +170,11 @@ func (events *BlockAndPrivateDataEventsRequest) Events(ctx context.Context, opts
 				return
 			}
 
-			results <- result
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
 		}
 	}()
 
@@ -58,6 +58,22 @@ func TestBlockEvents(t *testing.T) {
 		require.Errorf(t, err, expected.Error(), "error message")
 	})
 
+	t.Run("Returns error for invalid channel name without sending request", func(t *testing.T) {
+		for _, name := range []string{"", "white space", "control\ncharacter"} {
+			mockClient := NewMockDeliverClient(gomock.NewController(t))
+			network := AssertNewTestNetwork(t, name, WithDeliverClient(mockClient))
+
+			_, err := network.NewBlockEventsRequest()
+			require.Error(t, err, "block events channel name: %q", name)
+
+			_, err = network.NewFilteredBlockEventsRequest()
+			require.Error(t, err, "filtered block events channel name: %q", name)
+
+			_, err = network.NewBlockAndPrivateDataEventsRequest()
+			require.Error(t, err, "block and private data events channel name: %q", name)
+		}
+	})
+
 	t.Run("Sends valid request with default start position", func(t *testing.T) {
 		controller := gomock.NewController(t)
 		mockClient := NewMockDeliverClient(controller)
@@ -420,6 +436,42 @@ func TestBlockEvents(t *testing.T) {
 		}
 	})
 
+	t.Run("Closes event channel on context cancel while events are being received", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		mockClient := NewMockDeliverClient(controller)
+		mockEvents := NewMockDeliver_DeliverClient(controller)
+
+		mockClient.EXPECT().Deliver(gomock.Any(), gomock.Any()).
+			Return(mockEvents, nil)
+
+		mockEvents.EXPECT().Send(gomock.Any()).
+			Return(nil)
+
+		mockEvents.EXPECT().Recv().
+			Return(&peer.DeliverResponse{
+				Type: &peer.DeliverResponse_Block{
+					Block: &common.Block{
+						Header: &common.BlockHeader{
+							Number: 1,
+						},
+					},
+				},
+			}, nil).
+			AnyTimes()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithDeliverClient(mockClient))
+		receive, err := network.BlockEvents(ctx, WithBufferSize(1))
+		require.NoError(t, err)
+
+		cancel()
+
+		for range receive {
+			// Drain any events received before cancellation was observed
+		}
+	})
+
 	t.Run("Closes event channel on non-block message", func(t *testing.T) {
 		controller := gomock.NewController(t)
 		mockClient := NewMockDeliverClient(controller)
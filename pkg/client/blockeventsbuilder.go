@@ -30,6 +30,10 @@ type baseBlockEventsBuilder struct {
 }
 
 func (builder *baseBlockEventsBuilder) payloadBytes() ([]byte, error) {
+	if err := validateName("channel", builder.channelName); err != nil {
+		return nil, err
+	}
+
 	channelHeader, err := builder.channelHeaderBytes()
 	if err != nil {
 		return nil, err
@@ -106,6 +110,7 @@ func (builder *filteredBlockEventsBuilder) build() (*FilteredBlockEventsRequest,
 			request: &common.Envelope{
 				Payload: payload,
 			},
+			bufferSize: builder.bufferSize,
 		},
 	}
 	return result, nil
@@ -128,6 +133,7 @@ func (builder *blockEventsBuilder) build() (*BlockEventsRequest, error) {
 			request: &common.Envelope{
 				Payload: payload,
 			},
+			bufferSize: builder.bufferSize,
 		},
 	}
 	return result, nil
@@ -150,6 +156,7 @@ func (builder *blockAndPrivateDataEventsBuilder) build() (*BlockAndPrivateDataEv
 			request: &common.Envelope{
 				Payload: payload,
 			},
+			bufferSize: builder.bufferSize,
 		},
 	}
 	return result, nil
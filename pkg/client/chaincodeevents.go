@@ -20,6 +20,7 @@ type ChaincodeEventsRequest struct {
 	client        *gatewayClient
 	signingID     *signingIdentity
 	signedRequest *gateway.SignedChaincodeEventsRequest
+	bufferSize    int
 }
 
 // Bytes of the serialized chaincode events request.
@@ -37,7 +38,8 @@ func (events *ChaincodeEventsRequest) Digest() []byte {
 	return events.signingID.Hash(events.signedRequest.GetRequest())
 }
 
-// Events returns a channel from which chaincode events can be read.
+// Events returns a channel from which chaincode events can be read. Events are delivered in order and are not dropped
+// if the consumer is slow to read them. The channel is closed when the context is cancelled or the event stream fails.
 func (events *ChaincodeEventsRequest) Events(ctx context.Context, opts ...grpc.CallOption) (<-chan *ChaincodeEvent, error) {
 	if err := events.sign(); err != nil {
 		return nil, err
@@ -48,7 +50,7 @@ func (events *ChaincodeEventsRequest) Events(ctx context.Context, opts ...grpc.C
 		return nil, err
 	}
 
-	results := make(chan *ChaincodeEvent)
+	results := make(chan *ChaincodeEvent, events.bufferSize)
 	go func() {
 		defer close(results)
 
@@ -58,7 +60,9 @@ func (events *ChaincodeEventsRequest) Events(ctx context.Context, opts ...grpc.C
 				return
 			}
 
-			deliverChaincodeEvents(response, results)
+			if !deliverChaincodeEvents(ctx, response, results) {
+				return
+			}
 		}
 	}()
 
@@ -98,14 +102,22 @@ type ChaincodeEvent struct {
 	Payload       []byte
 }
 
-func deliverChaincodeEvents(response *gateway.ChaincodeEventsResponse, send chan<- *ChaincodeEvent) {
+func deliverChaincodeEvents(ctx context.Context, response *gateway.ChaincodeEventsResponse, send chan<- *ChaincodeEvent) bool {
 	for _, event := range response.GetEvents() {
-		send <- &ChaincodeEvent{
+		chaincodeEvent := &ChaincodeEvent{
 			BlockNumber:   response.GetBlockNumber(),
 			TransactionID: event.GetTxId(),
 			ChaincodeName: event.GetChaincodeId(),
 			EventName:     event.GetEventName(),
 			Payload:       event.GetPayload(),
 		}
+
+		select {
+		case send <- chaincodeEvent:
+		case <-ctx.Done():
+			return false
+		}
 	}
+
+	return true
 }
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hyperledger/fabric-gateway/pkg/internal/test"
@@ -59,6 +60,20 @@ func TestChaincodeEvents(t *testing.T) {
 		require.Errorf(t, err, expected.Error(), "error message")
 	})
 
+	t.Run("Returns error for invalid channel or chaincode name without sending request", func(t *testing.T) {
+		for _, name := range []string{"", "white space", "control\ncharacter"} {
+			mockClient := NewMockGatewayClient(gomock.NewController(t))
+
+			network := AssertNewTestNetwork(t, name, WithGatewayClient(mockClient))
+			_, err := network.NewChaincodeEventsRequest("CHAINCODE")
+			require.Error(t, err, "channel name: %q", name)
+
+			network = AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+			_, err = network.NewChaincodeEventsRequest(name)
+			require.Error(t, err, "chaincode name: %q", name)
+		}
+	})
+
 	t.Run("Sends valid request with default start position", func(t *testing.T) {
 		controller := gomock.NewController(t)
 		mockClient := NewMockGatewayClient(controller)
@@ -516,6 +531,98 @@ func TestChaincodeEvents(t *testing.T) {
 		}
 	})
 
+	t.Run("Buffers events up to specified buffer size", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		mockClient := NewMockGatewayClient(controller)
+		mockEvents := NewMockGateway_ChaincodeEventsClient(controller)
+
+		mockClient.EXPECT().ChaincodeEvents(gomock.Any(), gomock.Any()).
+			Return(mockEvents, nil)
+
+		expected := []*ChaincodeEvent{
+			{
+				BlockNumber:   1,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_1",
+				Payload:       []byte("PAYLOAD_1"),
+				TransactionID: "TRANSACTION_ID_1",
+			},
+			{
+				BlockNumber:   1,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_2",
+				Payload:       []byte("PAYLOAD_2"),
+				TransactionID: "TRANSACTION_ID_2",
+			},
+		}
+
+		responseIndex := 0
+		mockEvents.EXPECT().Recv().
+			DoAndReturn(func() (*gateway.ChaincodeEventsResponse, error) {
+				if responseIndex > 0 {
+					return nil, errors.New("fake")
+				}
+				responseIndex++
+				return newChaincodeEventsResponse(expected), nil
+			}).
+			AnyTimes()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+		receive, err := network.ChaincodeEvents(ctx, "CHAINCODE", WithBufferSize(len(expected)))
+		require.NoError(t, err)
+
+		require.Equal(t, len(expected), cap(receive), "capacity")
+		require.Eventually(t, func() bool { return len(receive) == len(expected) }, time.Second, 10*time.Millisecond)
+
+		for _, event := range expected {
+			actual := <-receive
+			require.EqualValues(t, event, actual)
+		}
+	})
+
+	t.Run("Returns error for negative buffer size", func(t *testing.T) {
+		network := AssertNewTestNetwork(t, "NETWORK")
+
+		_, err := network.ChaincodeEvents(context.Background(), "CHAINCODE", WithBufferSize(-1))
+
+		require.Error(t, err)
+	})
+
+	t.Run("Closes event channel on context cancel while events are being received", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		mockClient := NewMockGatewayClient(controller)
+		mockEvents := NewMockGateway_ChaincodeEventsClient(controller)
+
+		mockClient.EXPECT().ChaincodeEvents(gomock.Any(), gomock.Any()).
+			Return(mockEvents, nil)
+
+		event := &ChaincodeEvent{
+			BlockNumber:   1,
+			ChaincodeName: "CHAINCODE",
+			EventName:     "EVENT",
+			Payload:       []byte("PAYLOAD"),
+			TransactionID: "TRANSACTION_ID",
+		}
+		mockEvents.EXPECT().Recv().
+			Return(newChaincodeEventsResponse([]*ChaincodeEvent{event}), nil).
+			AnyTimes()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+		receive, err := network.ChaincodeEvents(ctx, "CHAINCODE")
+		require.NoError(t, err)
+
+		cancel()
+
+		for range receive {
+			// Drain any events received before cancellation was observed
+		}
+	})
+
 	t.Run("Uses specified gRPC call options", func(t *testing.T) {
 		var actual []grpc.CallOption
 		expected := grpc.WaitForReady(true)
@@ -28,6 +28,7 @@ func (builder *chaincodeEventsBuilder) build() (*ChaincodeEventsRequest, error)
 		client:        builder.client,
 		signingID:     builder.signingID,
 		signedRequest: signedRequest,
+		bufferSize:    builder.bufferSize,
 	}
 	return result, nil
 }
@@ -50,6 +51,13 @@ func (builder *chaincodeEventsBuilder) newSignedChaincodeEventsRequestProto() (*
 }
 
 func (builder *chaincodeEventsBuilder) newChaincodeEventsRequestProto() (*gateway.ChaincodeEventsRequest, error) {
+	if err := validateName("channel", builder.channelName); err != nil {
+		return nil, err
+	}
+	if err := validateName("chaincode", builder.chaincodeName); err != nil {
+		return nil, err
+	}
+
 	creator, err := builder.signingID.Creator()
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize identity: %w", err)
@@ -32,7 +32,10 @@ func (client *gatewayClient) EndorseWithContext(ctx context.Context, in *gateway
 	response, err := client.grpcGatewayClient.Endorse(ctx, in, opts...)
 	if err != nil {
 		txErr := newTransactionError(err, in.GetTransactionId())
-		return nil, &EndorseError{txErr}
+		return nil, &EndorseError{
+			TransactionError: txErr,
+			ChannelID:        in.GetChannelId(),
+		}
 	}
 
 	return response, nil
@@ -0,0 +1,304 @@
+/*
+Copyright 2022 IBM All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package clienttest provides an in-memory Fabric Gateway that can be used to unit test client applications without
+// a running Fabric network.
+//
+// A Gateway is a gRPC client connection, and can be supplied when connecting a client application:
+//
+//	gw := clienttest.NewGateway()
+//	gw.SetResponse("contractName:transactionName", clienttest.Response{Result: []byte("result")})
+//	connection, err := client.Connect(id, client.WithSign(sign), client.WithClientConnection(gw))
+package clienttest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	evaluateMethod     = "/gateway.Gateway/Evaluate"
+	endorseMethod      = "/gateway.Gateway/Endorse"
+	submitMethod       = "/gateway.Gateway/Submit"
+	commitStatusMethod = "/gateway.Gateway/CommitStatus"
+)
+
+// Response to a transaction function invocation. The zero value is a successful invocation with an empty result that
+// commits as a valid transaction.
+type Response struct {
+	// Result returned by the transaction function.
+	Result []byte
+	// Err is returned by Evaluate and Endorse calls instead of a result. Use a gRPC status error to simulate failures
+	// reported by the Fabric Gateway.
+	Err error
+	// SubmitErr is returned by Submit calls for a successfully endorsed transaction.
+	SubmitErr error
+	// CommitStatusErr is returned by CommitStatus calls for a successfully endorsed transaction, instead of the
+	// ValidationCode and BlockNumber.
+	CommitStatusErr error
+	// ValidationCode reported by the commit status of a submitted transaction.
+	ValidationCode peer.TxValidationCode
+	// BlockNumber reported by the commit status of a submitted transaction.
+	BlockNumber uint64
+}
+
+// Gateway is an in-memory Fabric Gateway that returns canned responses keyed by transaction name. The transaction name
+// is the fully qualified name passed to the transaction function, such as "contractName:transactionName" when using a
+// named contract. Invocations of transactions with no response set fail with a NotFound status. Event listening is not
+// supported. A Gateway is safe for concurrent use.
+type Gateway struct {
+	lock      sync.Mutex
+	responses map[string]Response
+	submitted map[string]Response
+}
+
+// NewGateway creates an in-memory Fabric Gateway with no responses set.
+func NewGateway() *Gateway {
+	return &Gateway{
+		responses: make(map[string]Response),
+		submitted: make(map[string]Response),
+	}
+}
+
+// SetResponse sets the response returned for invocations of the named transaction, replacing any existing response.
+func (gw *Gateway) SetResponse(transactionName string, response Response) {
+	gw.lock.Lock()
+	defer gw.lock.Unlock()
+
+	gw.responses[transactionName] = response
+}
+
+// Invoke a unary gRPC method. This implements grpc.ClientConnInterface.
+func (gw *Gateway) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	var response proto.Message
+	var err error
+
+	switch method {
+	case evaluateMethod:
+		response, err = gw.evaluate(args.(*gateway.EvaluateRequest))
+	case endorseMethod:
+		response, err = gw.endorse(args.(*gateway.EndorseRequest))
+	case submitMethod:
+		response, err = gw.submit(args.(*gateway.SubmitRequest))
+	case commitStatusMethod:
+		response, err = gw.commitStatus(args.(*gateway.SignedCommitStatusRequest))
+	default:
+		err = status.Errorf(codes.Unimplemented, "method %s not implemented", method)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	proto.Merge(reply.(proto.Message), response)
+	return nil
+}
+
+// NewStream is not supported and always returns an Unimplemented status error. This implements
+// grpc.ClientConnInterface.
+func (gw *Gateway) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method %s not implemented", method)
+}
+
+func (gw *Gateway) evaluate(request *gateway.EvaluateRequest) (*gateway.EvaluateResponse, error) {
+	response, err := gw.responseForProposal(request.GetProposedTransaction())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &gateway.EvaluateResponse{
+		Result: &peer.Response{
+			Status:  int32(common.Status_SUCCESS),
+			Payload: response.Result,
+		},
+	}
+	return result, nil
+}
+
+func (gw *Gateway) endorse(request *gateway.EndorseRequest) (*gateway.EndorseResponse, error) {
+	response, err := gw.responseForProposal(request.GetProposedTransaction())
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := newPreparedTransaction(request.GetChannelId(), request.GetTransactionId(), response.Result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	gw.lock.Lock()
+	defer gw.lock.Unlock()
+
+	gw.submitted[request.GetTransactionId()] = response
+
+	result := &gateway.EndorseResponse{
+		PreparedTransaction: envelope,
+	}
+	return result, nil
+}
+
+func (gw *Gateway) submit(request *gateway.SubmitRequest) (*gateway.SubmitResponse, error) {
+	response, err := gw.submittedResponse(request.GetTransactionId())
+	if err != nil {
+		return nil, err
+	}
+
+	if response.SubmitErr != nil {
+		return nil, response.SubmitErr
+	}
+
+	return &gateway.SubmitResponse{}, nil
+}
+
+func (gw *Gateway) commitStatus(request *gateway.SignedCommitStatusRequest) (*gateway.CommitStatusResponse, error) {
+	statusRequest := &gateway.CommitStatusRequest{}
+	if err := proto.Unmarshal(request.GetRequest(), statusRequest); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to deserialize commit status request: %v", err)
+	}
+
+	response, err := gw.submittedResponse(statusRequest.GetTransactionId())
+	if err != nil {
+		return nil, err
+	}
+
+	if response.CommitStatusErr != nil {
+		return nil, response.CommitStatusErr
+	}
+
+	result := &gateway.CommitStatusResponse{
+		Result:      response.ValidationCode,
+		BlockNumber: response.BlockNumber,
+	}
+	return result, nil
+}
+
+func (gw *Gateway) responseForProposal(proposal *peer.SignedProposal) (Response, error) {
+	transactionName, err := transactionNameFromProposal(proposal)
+	if err != nil {
+		return Response{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	gw.lock.Lock()
+	defer gw.lock.Unlock()
+
+	response, ok := gw.responses[transactionName]
+	if !ok {
+		return Response{}, status.Errorf(codes.NotFound, "no response set for transaction %s", transactionName)
+	}
+
+	if response.Err != nil {
+		return Response{}, response.Err
+	}
+
+	return response, nil
+}
+
+func (gw *Gateway) submittedResponse(transactionID string) (Response, error) {
+	gw.lock.Lock()
+	defer gw.lock.Unlock()
+
+	response, ok := gw.submitted[transactionID]
+	if !ok {
+		return Response{}, status.Errorf(codes.NotFound, "transaction %s not endorsed", transactionID)
+	}
+
+	return response, nil
+}
+
+func transactionNameFromProposal(signedProposal *peer.SignedProposal) (string, error) {
+	proposal := &peer.Proposal{}
+	if err := proto.Unmarshal(signedProposal.GetProposalBytes(), proposal); err != nil {
+		return "", fmt.Errorf("failed to deserialize proposal: %w", err)
+	}
+
+	payload := &peer.ChaincodeProposalPayload{}
+	if err := proto.Unmarshal(proposal.GetPayload(), payload); err != nil {
+		return "", fmt.Errorf("failed to deserialize chaincode proposal payload: %w", err)
+	}
+
+	invocationSpec := &peer.ChaincodeInvocationSpec{}
+	if err := proto.Unmarshal(payload.GetInput(), invocationSpec); err != nil {
+		return "", fmt.Errorf("failed to deserialize chaincode invocation spec: %w", err)
+	}
+
+	args := invocationSpec.GetChaincodeSpec().GetInput().GetArgs()
+	if len(args) == 0 {
+		return "", fmt.Errorf("no transaction name in proposal")
+	}
+
+	return string(args[0]), nil
+}
+
+func newPreparedTransaction(channelName string, transactionID string, result []byte) (*common.Envelope, error) {
+	chaincodeAction, err := proto.Marshal(&peer.ChaincodeAction{
+		Response: &peer.Response{
+			Status:  int32(common.Status_SUCCESS),
+			Payload: result,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responsePayload, err := proto.Marshal(&peer.ProposalResponsePayload{
+		Extension: chaincodeAction,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	actionPayload, err := proto.Marshal(&peer.ChaincodeActionPayload{
+		Action: &peer.ChaincodeEndorsedAction{
+			ProposalResponsePayload: responsePayload,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := proto.Marshal(&peer.Transaction{
+		Actions: []*peer.TransactionAction{
+			{Payload: actionPayload},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	channelHeader, err := proto.Marshal(&common.ChannelHeader{
+		Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+		ChannelId: channelName,
+		TxId:      transactionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := proto.Marshal(&common.Payload{
+		Header: &common.Header{
+			ChannelHeader: channelHeader,
+		},
+		Data: transaction,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.Envelope{Payload: payload}, nil
+}
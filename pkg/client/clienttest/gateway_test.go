@@ -0,0 +1,149 @@
+/*
+Copyright 2022 IBM All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clienttest_test
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"github.com/hyperledger/fabric-gateway/pkg/client/clienttest"
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
+	"github.com/hyperledger/fabric-gateway/pkg/internal/test"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func AssertNewTestContract(t *testing.T, gw *clienttest.Gateway) *client.Contract {
+	privateKey, err := test.NewECDSAPrivateKey()
+	require.NoError(t, err)
+
+	certificate, err := test.NewCertificate(privateKey)
+	require.NoError(t, err)
+
+	id, err := identity.NewX509Identity("mspID", certificate)
+	require.NoError(t, err)
+
+	sign, err := identity.NewPrivateKeySign(privateKey)
+	require.NoError(t, err)
+
+	connection, err := client.Connect(id, client.WithSign(sign), client.WithClientConnection(gw))
+	require.NoError(t, err)
+	t.Cleanup(func() { connection.Close() })
+
+	return connection.GetNetwork("network").GetContractWithName("chaincode", "contract")
+}
+
+func TestGateway(t *testing.T) {
+	t.Run("Evaluate returns result for transaction name", func(t *testing.T) {
+		gw := clienttest.NewGateway()
+		gw.SetResponse("contract:transaction", clienttest.Response{Result: []byte("RESULT")})
+		contract := AssertNewTestContract(t, gw)
+
+		actual, err := contract.EvaluateTransaction("transaction")
+		require.NoError(t, err)
+
+		require.EqualValues(t, "RESULT", actual)
+	})
+
+	t.Run("Evaluate returns NotFound error for transaction with no response", func(t *testing.T) {
+		gw := clienttest.NewGateway()
+		contract := AssertNewTestContract(t, gw)
+
+		_, err := contract.EvaluateTransaction("transaction")
+
+		require.Equal(t, codes.NotFound, status.Code(err), "status code")
+	})
+
+	t.Run("Evaluate returns specified error", func(t *testing.T) {
+		gw := clienttest.NewGateway()
+		gw.SetResponse("contract:transaction", clienttest.Response{Err: status.Error(codes.Aborted, "MOCK_ERROR")})
+		contract := AssertNewTestContract(t, gw)
+
+		_, err := contract.EvaluateTransaction("transaction")
+
+		require.Equal(t, codes.Aborted, status.Code(err), "status code")
+		require.ErrorContains(t, err, "MOCK_ERROR")
+	})
+
+	t.Run("Submit returns result for transaction name", func(t *testing.T) {
+		gw := clienttest.NewGateway()
+		gw.SetResponse("contract:transaction", clienttest.Response{Result: []byte("RESULT")})
+		contract := AssertNewTestContract(t, gw)
+
+		actual, err := contract.SubmitTransaction("transaction")
+		require.NoError(t, err)
+
+		require.EqualValues(t, "RESULT", actual)
+	})
+
+	t.Run("Submit returns endorse error", func(t *testing.T) {
+		gw := clienttest.NewGateway()
+		gw.SetResponse("contract:transaction", clienttest.Response{Err: status.Error(codes.Aborted, "MOCK_ERROR")})
+		contract := AssertNewTestContract(t, gw)
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var endorseErr *client.EndorseError
+		require.ErrorAs(t, err, &endorseErr)
+		require.Equal(t, codes.Aborted, status.Code(err), "status code")
+	})
+
+	t.Run("Submit returns specified submit error", func(t *testing.T) {
+		gw := clienttest.NewGateway()
+		gw.SetResponse("contract:transaction", clienttest.Response{SubmitErr: status.Error(codes.Unavailable, "MOCK_ERROR")})
+		contract := AssertNewTestContract(t, gw)
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var submitErr *client.SubmitError
+		require.ErrorAs(t, err, &submitErr)
+		require.Equal(t, codes.Unavailable, status.Code(err), "status code")
+		require.ErrorContains(t, err, "MOCK_ERROR")
+	})
+
+	t.Run("Submit returns specified commit status error", func(t *testing.T) {
+		gw := clienttest.NewGateway()
+		gw.SetResponse("contract:transaction", clienttest.Response{CommitStatusErr: status.Error(codes.DeadlineExceeded, "MOCK_ERROR")})
+		contract := AssertNewTestContract(t, gw)
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var commitStatusErr *client.CommitStatusError
+		require.ErrorAs(t, err, &commitStatusErr)
+		require.Equal(t, codes.DeadlineExceeded, status.Code(err), "status code")
+		require.ErrorContains(t, err, "MOCK_ERROR")
+	})
+
+	t.Run("Submit returns commit error for invalid validation code", func(t *testing.T) {
+		gw := clienttest.NewGateway()
+		gw.SetResponse("contract:transaction", clienttest.Response{ValidationCode: peer.TxValidationCode_MVCC_READ_CONFLICT})
+		contract := AssertNewTestContract(t, gw)
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var commitErr *client.CommitError
+		require.ErrorAs(t, err, &commitErr)
+		require.Equal(t, peer.TxValidationCode_MVCC_READ_CONFLICT, commitErr.Code)
+	})
+
+	t.Run("Commit status includes block number", func(t *testing.T) {
+		gw := clienttest.NewGateway()
+		gw.SetResponse("contract:transaction", clienttest.Response{BlockNumber: 101})
+		contract := AssertNewTestContract(t, gw)
+
+		_, commit, err := contract.SubmitAsync("transaction")
+		require.NoError(t, err)
+
+		commitStatus, err := commit.Status()
+		require.NoError(t, err)
+
+		require.True(t, commitStatus.Successful)
+		require.EqualValues(t, 101, commitStatus.BlockNumber)
+	})
+}
@@ -6,6 +6,17 @@ SPDX-License-Identifier: Apache-2.0
 
 package client
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
 // Contract represents a smart contract, and allows applications to:
 //
 // - Evaluate transactions that query state from the ledger using the EvaluateTransaction() method.
@@ -57,6 +68,31 @@ func (contract *Contract) EvaluateTransaction(name string, args ...string) ([]by
 	return contract.Evaluate(name, WithArguments(args...))
 }
 
+// EvaluateTransactionInto will evaluate a transaction function and unmarshal its protobuf encoded result into the
+// supplied message. This can be used with transaction functions that return protobuf messages.
+//
+// This method is equivalent to:
+//
+//	result, err := contract.EvaluateTransaction(name, args...)
+//	client.UnmarshalResult(result, m)
+func (contract *Contract) EvaluateTransactionInto(m proto.Message, name string, args ...string) error {
+	result, err := contract.EvaluateTransaction(name, args...)
+	if err != nil {
+		return err
+	}
+
+	return UnmarshalResult(result, m)
+}
+
+// UnmarshalResult unmarshals a protobuf encoded transaction result into the supplied message.
+func UnmarshalResult(result []byte, m proto.Message) error {
+	if err := proto.Unmarshal(result, m); err != nil {
+		return fmt.Errorf("failed to deserialize transaction result: %w", err)
+	}
+
+	return nil
+}
+
 // Evaluate a transaction function and return its result. This method provides greater control over the transaction
 // proposal content and the endorsing peers on which it is evaluated. This allows transaction functions to be evaluated
 // where the proposal must include transient data, or that will access ledger data with key-based endorsement policies.
@@ -69,6 +105,113 @@ func (contract *Contract) Evaluate(transactionName string, options ...ProposalOp
 	return proposal.Evaluate()
 }
 
+// EvaluateWithContext uses the supplied context to evaluate a transaction function and return its result. If the
+// context is cancelled or its deadline is exceeded before the result is obtained, the call is aborted and the context
+// error is returned.
+func (contract *Contract) EvaluateWithContext(ctx context.Context, transactionName string, options ...ProposalOption) ([]byte, error) {
+	proposal, err := contract.NewProposal(transactionName, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal.EvaluateWithContext(ctx)
+}
+
+// EvaluateRequest is a transaction function to evaluate as part of a batch using EvaluateBatch().
+type EvaluateRequest struct {
+	TransactionName string
+	Options         []ProposalOption
+}
+
+// EvaluateResult of a transaction function evaluated as part of a batch using EvaluateBatch(). Err is set if the
+// evaluation failed.
+type EvaluateResult struct {
+	Result []byte
+	Err    error
+}
+
+// EvaluateBatch uses the supplied context to evaluate multiple transaction functions concurrently, with no more than
+// maxConcurrency evaluations in progress at once. A maxConcurrency of zero places no limit on concurrent evaluations.
+// Results are returned in the same order as the requests. A failure to evaluate one request is reported in its result
+// and does not prevent evaluation of the other requests.
+//
+// If the context is cancelled or its deadline is exceeded before all the requests are evaluated, outstanding
+// evaluations are aborted and the context error is returned along with the results. Results for requests that
+// completed are retained. Requests that were not started, or that were aborted while in progress, report the context
+// error. No error is returned if all the requests completed before the context was done.
+func (contract *Contract) EvaluateBatch(ctx context.Context, requests []EvaluateRequest, maxConcurrency int) ([]EvaluateResult, error) {
+	if maxConcurrency < 0 {
+		return nil, errors.New("maximum concurrency must not be negative")
+	}
+	if maxConcurrency == 0 {
+		maxConcurrency = len(requests)
+	}
+
+	results := make([]EvaluateResult, len(requests))
+	semaphore := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+
+	for i, request := range requests {
+		if !acquireSemaphore(ctx, semaphore) {
+			results[i] = EvaluateResult{Err: ctx.Err()}
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(i int, request EvaluateRequest) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			result, err := contract.EvaluateWithContext(ctx, request.TransactionName, request.Options...)
+			results[i] = EvaluateResult{
+				Result: result,
+				Err:    contextError(ctx, err),
+			}
+		}(i, request)
+	}
+
+	wg.Wait()
+
+	for _, result := range results {
+		if result.Err != nil && result.Err == ctx.Err() {
+			return results, result.Err
+		}
+	}
+
+	return results, nil
+}
+
+// contextError returns the context error in place of a gRPC error caused by the context being done.
+func contextError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+
+	switch status.Code(err) {
+	case codes.Canceled, codes.DeadlineExceeded:
+		return ctx.Err()
+	default:
+		return err
+	}
+}
+
+func acquireSemaphore(ctx context.Context, semaphore chan struct{}) bool {
+	select {
+	case semaphore <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+
+	// Both cases may be ready together, so do not start new work once the context is done
+	if ctx.Err() != nil {
+		<-semaphore
+		return false
+	}
+
+	return true
+}
+
 // SubmitTransaction will submit a transaction to the ledger and return its result only after it is committed to the
 // ledger. The transaction function will be evaluated on endorsing peers and then submitted to the ordering service to
 // be committed to the ledger.
@@ -7,8 +7,10 @@ SPDX-License-Identifier: Apache-2.0
 package client
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
 	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
 	"google.golang.org/grpc/status"
 )
@@ -25,6 +27,20 @@ func (e *grpcError) Unwrap() error {
 	return e.error
 }
 
+// Details of the gRPC status error. These identify the specific peer or ordering nodes that failed, and the reason for
+// each failure.
+func (e *grpcError) Details() []*gateway.ErrorDetail {
+	var results []*gateway.ErrorDetail
+
+	for _, detail := range e.GRPCStatus().Details() {
+		if errorDetail, ok := detail.(*gateway.ErrorDetail); ok {
+			results = append(results, errorDetail)
+		}
+	}
+
+	return results
+}
+
 func newTransactionError(err error, transactionID string) *TransactionError {
 	if err == nil {
 		return nil
@@ -45,6 +61,18 @@ type TransactionError struct {
 // EndorseError represents a failure endorsing a transaction proposal.
 type EndorseError struct {
 	*TransactionError
+	ChannelID string
+}
+
+// EndorseErrorDetails returns the details of an endorse error, identifying the endorsing peers that failed and the
+// reason for each failure. Returns nil if the error chain does not contain an EndorseError.
+func EndorseErrorDetails(err error) []*gateway.ErrorDetail {
+	var endorseErr *EndorseError
+	if !errors.As(err, &endorseErr) {
+		return nil
+	}
+
+	return endorseErr.Details()
 }
 
 // SubmitError represents a failure submitting an endorsed transaction to the orderer.
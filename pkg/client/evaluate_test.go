@@ -8,10 +8,13 @@ package client
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hyperledger/fabric-gateway/pkg/hash"
 	"github.com/hyperledger/fabric-gateway/pkg/internal/test"
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
 	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
@@ -19,6 +22,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/runtime/protoiface"
 )
 
@@ -66,6 +70,35 @@ func TestEvaluateTransaction(t *testing.T) {
 		require.EqualValues(t, expected, actual)
 	})
 
+	t.Run("Unmarshals protobuf result into message", func(t *testing.T) {
+		expected := &peer.ChaincodeID{Name: "RESULT_NAME"}
+		resultBytes, err := proto.Marshal(expected)
+		require.NoError(t, err)
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			Return(newEvaluateResponse(resultBytes), nil)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		actual := &peer.ChaincodeID{}
+		err = contract.EvaluateTransactionInto(actual, "transaction")
+		require.NoError(t, err)
+
+		test.AssertProtoEqual(t, expected, actual)
+	})
+
+	t.Run("Returns error for malformed protobuf result", func(t *testing.T) {
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			Return(newEvaluateResponse([]byte("MALFORMED")), nil)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		err := contract.EvaluateTransactionInto(&peer.ChaincodeID{}, "transaction")
+
+		require.ErrorContains(t, err, "failed to deserialize transaction result")
+	})
+
 	t.Run("Includes channel name in proposal", func(t *testing.T) {
 		var actual string
 		mockClient := NewMockGatewayClient(gomock.NewController(t))
@@ -164,6 +197,94 @@ func TestEvaluateTransaction(t *testing.T) {
 		require.EqualValues(t, expected, actual, "got Args: %s", args)
 	})
 
+	t.Run("Includes JSON arguments in proposal", func(t *testing.T) {
+		var args [][]byte
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, in *gateway.EvaluateRequest, _ ...grpc.CallOption) {
+				args = test.AssertUnmarshalInvocationSpec(t, in.ProposedTransaction).ChaincodeSpec.Input.Args
+			}).
+			Return(newEvaluateResponse(nil), nil).
+			Times(1)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		value := struct {
+			Name  string
+			Count int
+		}{"one", 2}
+		_, err := contract.Evaluate("transaction", WithJSONArguments(value, "three"))
+		require.NoError(t, err)
+
+		expected := []string{`{"Name":"one","Count":2}`, `"three"`}
+		actual := bytesAsStrings(args[1:])
+		require.EqualValues(t, expected, actual, "got Args: %s", args)
+	})
+
+	t.Run("Returns error for JSON argument that cannot be marshalled without evaluating", func(t *testing.T) {
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		_, err := contract.Evaluate("transaction", WithJSONArguments(make(chan int)))
+
+		require.Error(t, err)
+	})
+
+	t.Run("Includes transformed arguments in proposal", func(t *testing.T) {
+		var args [][]byte
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, in *gateway.EvaluateRequest, _ ...grpc.CallOption) {
+				args = test.AssertUnmarshalInvocationSpec(t, in.ProposedTransaction).ChaincodeSpec.Input.Args
+			}).
+			Return(newEvaluateResponse(nil), nil).
+			Times(1)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		prefix := func(prefix string) ArgumentTransformer {
+			return func(args [][]byte) ([][]byte, error) {
+				results := make([][]byte, 0, len(args))
+				for _, arg := range args {
+					results = append(results, append([]byte(prefix), arg...))
+				}
+				return results, nil
+			}
+		}
+		_, err := contract.Evaluate(
+			"transaction",
+			WithArgumentTransformer(prefix("A:")),
+			WithArguments("one", "two"),
+			WithArgumentTransformer(prefix("B:")),
+		)
+		require.NoError(t, err)
+
+		expected := []string{"B:A:one", "B:A:two"}
+		actual := bytesAsStrings(args[1:])
+		require.EqualValues(t, expected, actual, "got Args: %s", args)
+	})
+
+	t.Run("Returns argument transformer error without evaluating", func(t *testing.T) {
+		expected := errors.New("TRANSFORM_ERROR")
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		_, err := contract.Evaluate("transaction", WithArgumentTransformer(func(args [][]byte) ([][]byte, error) {
+			return nil, expected
+		}))
+
+		require.ErrorIs(t, err, expected)
+	})
+
+	t.Run("Returns error for nil argument transformer", func(t *testing.T) {
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		_, err := contract.Evaluate("transaction", WithArgumentTransformer(nil))
+
+		require.Error(t, err)
+	})
+
 	t.Run("Includes channel name in proposed transaction", func(t *testing.T) {
 		var actual string
 		mockClient := NewMockGatewayClient(gomock.NewController(t))
@@ -203,6 +324,44 @@ func TestEvaluateTransaction(t *testing.T) {
 		require.Equal(t, proposal.TransactionID(), actual)
 	})
 
+	t.Run("Uses specified nonce", func(t *testing.T) {
+		var actual []byte
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, in *gateway.EvaluateRequest, _ ...grpc.CallOption) {
+				actual = test.AssertUnmarshalSignatureHeader(t, in.ProposedTransaction).Nonce
+			}).
+			Return(newEvaluateResponse(nil), nil).
+			Times(1)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		expected := []byte("NONCE")
+		_, err := contract.Evaluate("transaction", WithNonce(expected))
+		require.NoError(t, err, "Evaluate")
+
+		require.EqualValues(t, expected, actual)
+	})
+
+	t.Run("Transaction ID is derived from specified nonce", func(t *testing.T) {
+		contract := AssertNewTestContract(t, "chaincode")
+
+		proposal1, err := contract.NewProposal("transaction", WithNonce([]byte("NONCE")))
+		require.NoError(t, err, "NewProposal")
+		proposal2, err := contract.NewProposal("transaction", WithNonce([]byte("NONCE")))
+		require.NoError(t, err, "NewProposal")
+
+		require.Equal(t, proposal1.TransactionID(), proposal2.TransactionID())
+	})
+
+	t.Run("Returns error for empty nonce", func(t *testing.T) {
+		contract := AssertNewTestContract(t, "chaincode")
+
+		_, err := contract.NewProposal("transaction", WithNonce(nil))
+
+		require.Error(t, err)
+	})
+
 	t.Run("Includes transaction ID in evaluate request", func(t *testing.T) {
 		var actual string
 		mockClient := NewMockGatewayClient(gomock.NewController(t))
@@ -267,6 +426,28 @@ func TestEvaluateTransaction(t *testing.T) {
 		require.EqualValues(t, expected, actual)
 	})
 
+	t.Run("Signs full proposal bytes with NONE hash", func(t *testing.T) {
+		var actual []byte
+		sign := func(digest []byte) ([]byte, error) {
+			actual = digest
+			return []byte("SIGNATURE"), nil
+		}
+		var expected []byte
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, in *gateway.EvaluateRequest, _ ...grpc.CallOption) {
+				expected = in.ProposedTransaction.ProposalBytes
+			}).
+			Return(newEvaluateResponse(nil), nil)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient), WithSign(sign), WithHash(hash.NONE))
+
+		_, err := contract.EvaluateTransaction("transaction")
+		require.NoError(t, err)
+
+		require.EqualValues(t, expected, actual)
+	})
+
 	t.Run("Sends private data with evaluate", func(t *testing.T) {
 		var actualOrgs []string
 		expectedOrgs := []string{"MY_ORG"}
@@ -294,6 +475,35 @@ func TestEvaluateTransaction(t *testing.T) {
 		require.EqualValues(t, expectedPrice, actualPrice)
 	})
 
+	t.Run("Returns error for invalid transient data keys without evaluating", func(t *testing.T) {
+		for _, key := range []string{"", "null\x00character", "invalid\xffUTF-8"} {
+			mockClient := NewMockGatewayClient(gomock.NewController(t))
+			contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+			privateData := map[string][]byte{
+				key: []byte("3000"),
+			}
+
+			_, err := contract.Evaluate("transaction", WithTransient(privateData))
+
+			require.Error(t, err, "key: %q", key)
+		}
+	})
+
+	t.Run("Returns error for invalid channel or chaincode name without evaluating", func(t *testing.T) {
+		for _, name := range []string{"", "white space", "control\ncharacter"} {
+			mockClient := NewMockGatewayClient(gomock.NewController(t))
+
+			network := AssertNewTestNetwork(t, name, WithGatewayClient(mockClient))
+			_, err := network.GetContract("chaincode").EvaluateTransaction("transaction")
+			require.Error(t, err, "channel name: %q", name)
+
+			contract := AssertNewTestContract(t, name, WithGatewayClient(mockClient))
+			_, err = contract.EvaluateTransaction("transaction")
+			require.Error(t, err, "chaincode name: %q", name)
+		}
+	})
+
 	t.Run("Uses specified context", func(t *testing.T) {
 		var actual context.Context
 
@@ -320,6 +530,190 @@ func TestEvaluateTransaction(t *testing.T) {
 		require.NotNil(t, actual.Err(), "context done after explicit cancel")
 	})
 
+	t.Run("EvaluateBatch returns results in request order", func(t *testing.T) {
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in *gateway.EvaluateRequest, _ ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
+				args := test.AssertUnmarshalInvocationSpec(t, in.ProposedTransaction).ChaincodeSpec.Input.Args
+				if string(args[0]) == "failing" {
+					return nil, NewStatusError(t, codes.Aborted, "EVALUATE_ERROR")
+				}
+				return newEvaluateResponse(args[1]), nil
+			}).
+			Times(3)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		requests := []EvaluateRequest{
+			{TransactionName: "transaction", Options: []ProposalOption{WithArguments("ONE")}},
+			{TransactionName: "failing"},
+			{TransactionName: "transaction", Options: []ProposalOption{WithArguments("THREE")}},
+		}
+		actual, err := contract.EvaluateBatch(context.Background(), requests, 0)
+		require.NoError(t, err)
+
+		require.Len(t, actual, len(requests))
+		require.EqualValues(t, "ONE", actual[0].Result)
+		require.NoError(t, actual[0].Err)
+		require.Equal(t, codes.Aborted, status.Code(actual[1].Err), "status code")
+		require.EqualValues(t, "THREE", actual[2].Result)
+		require.NoError(t, actual[2].Err)
+	})
+
+	t.Run("EvaluateBatch limits concurrent evaluations", func(t *testing.T) {
+		var lock sync.Mutex
+		var inProgress, maxInProgress int
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *gateway.EvaluateRequest, _ ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
+				lock.Lock()
+				inProgress++
+				if inProgress > maxInProgress {
+					maxInProgress = inProgress
+				}
+				lock.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				lock.Lock()
+				inProgress--
+				lock.Unlock()
+
+				return newEvaluateResponse(nil), nil
+			}).
+			Times(6)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		requests := make([]EvaluateRequest, 6)
+		for i := range requests {
+			requests[i] = EvaluateRequest{TransactionName: "transaction"}
+		}
+		_, err := contract.EvaluateBatch(context.Background(), requests, 2)
+		require.NoError(t, err)
+
+		require.LessOrEqual(t, maxInProgress, 2)
+	})
+
+	t.Run("EvaluateBatch returns completed results and context error on cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *gateway.EvaluateRequest, _ ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
+				cancel()
+				return newEvaluateResponse([]byte("ONE")), nil
+			}).
+			Times(1)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		requests := []EvaluateRequest{
+			{TransactionName: "transaction"},
+			{TransactionName: "transaction"},
+			{TransactionName: "transaction"},
+		}
+		actual, err := contract.EvaluateBatch(ctx, requests, 1)
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, actual, len(requests))
+		require.EqualValues(t, "ONE", actual[0].Result)
+		require.NoError(t, actual[0].Err)
+		require.ErrorIs(t, actual[1].Err, context.Canceled)
+		require.ErrorIs(t, actual[2].Err, context.Canceled)
+	})
+
+	t.Run("EvaluateBatch reports context error for evaluation in progress on cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ *gateway.EvaluateRequest, _ ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
+				cancel()
+				<-ctx.Done()
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}).
+			Times(1)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		requests := []EvaluateRequest{
+			{TransactionName: "transaction"},
+			{TransactionName: "transaction"},
+		}
+		actual, err := contract.EvaluateBatch(ctx, requests, 1)
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, actual, len(requests))
+		require.ErrorIs(t, actual[0].Err, context.Canceled)
+		require.ErrorIs(t, actual[1].Err, context.Canceled)
+	})
+
+	t.Run("EvaluateBatch returns no error if all requests complete before cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var lock sync.Mutex
+		var count int
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *gateway.EvaluateRequest, _ ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				count++
+				if count == 2 {
+					cancel()
+				}
+				return newEvaluateResponse([]byte("RESULT")), nil
+			}).
+			Times(2)
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		requests := []EvaluateRequest{
+			{TransactionName: "transaction"},
+			{TransactionName: "transaction"},
+		}
+		actual, err := contract.EvaluateBatch(ctx, requests, 1)
+
+		require.NoError(t, err)
+		require.EqualValues(t, "RESULT", actual[0].Result)
+		require.EqualValues(t, "RESULT", actual[1].Result)
+	})
+
+	t.Run("EvaluateBatch returns error for negative concurrency", func(t *testing.T) {
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		_, err := contract.EvaluateBatch(context.Background(), []EvaluateRequest{{TransactionName: "transaction"}}, -1)
+
+		require.Error(t, err)
+	})
+
+	t.Run("Contract uses specified context", func(t *testing.T) {
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ *gateway.EvaluateRequest, _ ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
+				select {
+				case <-time.After(1 * time.Second):
+					return newEvaluateResponse(nil), nil
+				case <-ctx.Done(): // Cancelled context should cancel immediately, selecting this case
+					return nil, ctx.Err()
+				}
+			})
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := contract.EvaluateWithContext(ctx, "transaction")
+
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
 	t.Run("Uses default context", func(t *testing.T) {
 		mockClient := NewMockGatewayClient(gomock.NewController(t))
 		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
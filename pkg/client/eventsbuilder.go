@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package client
 
 import (
+	"errors"
+
 	"github.com/hyperledger/fabric-protos-go-apiv2/orderer"
 )
 
@@ -16,6 +18,7 @@ type eventsBuilder struct {
 	channelName        string
 	startPosition      *orderer.SeekPosition
 	afterTransactionID string
+	bufferSize         int
 }
 
 func (builder *eventsBuilder) getStartPosition() *orderer.SeekPosition {
@@ -77,3 +80,21 @@ func WithCheckpoint(checkpoint Checkpoint) eventOption {
 		return nil
 	}
 }
+
+// WithBufferSize specifies the capacity of the channel from which events are read. Events are always delivered in order
+// and are never dropped. When the channel is full, receipt of further events blocks until the consumer reads from the
+// channel or the context used to obtain the events is cancelled. The default is an unbuffered channel.
+//
+// The buffer size is not part of the serialized events request, so it is not retained by requests recreated using
+// Gateway functions such as NewSignedChaincodeEventsRequest or NewSignedBlockEventsRequest. Events from recreated
+// requests are always read from an unbuffered channel.
+func WithBufferSize(size int) eventOption {
+	return func(builder *eventsBuilder) error {
+		if size < 0 {
+			return errors.New("buffer size must not be negative")
+		}
+
+		builder.bufferSize = size
+		return nil
+	}
+}
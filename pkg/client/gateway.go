@@ -78,7 +78,10 @@ func (gw *Gateway) applyConnectOptions(options []ConnectOption) error {
 // ConnectOption implements an option that can be used when connecting to a Fabric Gateway.
 type ConnectOption = func(gateway *Gateway) error
 
-// WithSign uses the supplied signing implementation to sign messages sent by the Gateway.
+// WithSign uses the supplied signing implementation to sign messages sent by the Gateway. The signing implementation
+// must accept digests generated by the hash implementation supplied using WithHash, or hash.SHA256 if none is
+// supplied. This pairing cannot be checked when connecting. For private keys, use identity.NewPrivateKeySignAndHash to
+// obtain a signing implementation together with its matching hash implementation.
 func WithSign(sign identity.Sign) ConnectOption {
 	return func(gw *Gateway) error {
 		gw.signingID.sign = sign
@@ -86,7 +89,9 @@ func WithSign(sign identity.Sign) ConnectOption {
 	}
 }
 
-// WithHash uses the supplied hashing implementation to generate digital signatures.
+// WithHash uses the supplied hashing implementation to generate digital signatures. The hash implementation must match
+// the signing implementation supplied using WithSign; for example, Ed25519 signing requires hash.NONE, while ECDSA
+// signing requires a digest such as hash.SHA256.
 func WithHash(hash hash.Hash) ConnectOption {
 	return func(gw *Gateway) error {
 		gw.signingID.hash = hash
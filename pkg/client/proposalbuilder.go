@@ -7,6 +7,13 @@ SPDX-License-Identifier: Apache-2.0
 package client
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
 	"github.com/hyperledger/fabric-protos-go-apiv2/common"
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
 	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
@@ -24,6 +31,7 @@ type proposalBuilder struct {
 	transient       map[string][]byte
 	endorsingOrgs   []string
 	args            [][]byte
+	argTransformers []ArgumentTransformer
 }
 
 func newProposalBuilder(
@@ -33,6 +41,13 @@ func newProposalBuilder(
 	chaincodeName string,
 	transactionName string,
 ) (*proposalBuilder, error) {
+	if err := validateName("channel", channelName); err != nil {
+		return nil, err
+	}
+	if err := validateName("chaincode", chaincodeName); err != nil {
+		return nil, err
+	}
+
 	transactionCtx, err := newTransactionContext(signingID)
 	if err != nil {
 		return nil, err
@@ -49,7 +64,25 @@ func newProposalBuilder(
 	return builder, nil
 }
 
+func validateName(kind string, name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("%s name must not be empty", kind)
+	}
+
+	for _, r := range name {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return fmt.Errorf("%s name contains invalid character %q: %q", kind, r, name)
+		}
+	}
+
+	return nil
+}
+
 func (builder *proposalBuilder) build() (*Proposal, error) {
+	if err := builder.transformArgs(); err != nil {
+		return nil, err
+	}
+
 	proposalBytes, err := builder.proposalBytes()
 	if err != nil {
 		return nil, err
@@ -70,6 +103,19 @@ func (builder *proposalBuilder) build() (*Proposal, error) {
 	return proposal, nil
 }
 
+func (builder *proposalBuilder) transformArgs() error {
+	for _, transform := range builder.argTransformers {
+		args, err := transform(builder.args)
+		if err != nil {
+			return fmt.Errorf("failed to transform arguments: %w", err)
+		}
+
+		builder.args = args
+	}
+
+	return nil
+}
+
 func (builder *proposalBuilder) proposalBytes() ([]byte, error) {
 	headerBytes, err := builder.headerBytes()
 	if err != nil {
@@ -174,6 +220,40 @@ func WithArguments(args ...string) ProposalOption {
 	return WithBytesArguments(stringsAsBytes(args)...)
 }
 
+// WithJSONArguments appends to the transaction function arguments associated with a transaction proposal. Each value
+// is marshalled to JSON to create its argument.
+func WithJSONArguments(values ...interface{}) ProposalOption {
+	return func(builder *proposalBuilder) error {
+		for i, value := range values {
+			arg, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("failed to marshal argument %d to JSON: %w", i, err)
+			}
+
+			builder.args = append(builder.args, arg)
+		}
+
+		return nil
+	}
+}
+
+// ArgumentTransformer transforms transaction function arguments before they are included in a transaction proposal.
+type ArgumentTransformer = func(args [][]byte) ([][]byte, error)
+
+// WithArgumentTransformer specifies a transformer that is applied to the transaction function arguments once all
+// other proposal options have been applied, before the proposal is created. Transformers are applied in the order they
+// are specified. An error returned by a transformer aborts creation of the proposal.
+func WithArgumentTransformer(transform ArgumentTransformer) ProposalOption {
+	return func(builder *proposalBuilder) error {
+		if transform == nil {
+			return errors.New("argument transformer must not be nil")
+		}
+
+		builder.argTransformers = append(builder.argTransformers, transform)
+		return nil
+	}
+}
+
 func stringsAsBytes(strings []string) [][]byte {
 	results := make([][]byte, 0, len(strings))
 
@@ -185,14 +265,35 @@ func stringsAsBytes(strings []string) [][]byte {
 }
 
 // WithTransient specifies the transient data associated with a transaction proposal.
-// This is usually used in combination with WithEndorsingOrganizations for private data scenarios
+// This is usually used in combination with WithEndorsingOrganizations for private data scenarios.
+// Transient data keys must be non-empty, valid UTF-8 strings containing no null characters.
 func WithTransient(transient map[string][]byte) ProposalOption {
 	return func(builder *proposalBuilder) error {
+		for key := range transient {
+			if err := validateTransientKey(key); err != nil {
+				return err
+			}
+		}
+
 		builder.transient = transient
 		return nil
 	}
 }
 
+func validateTransientKey(key string) error {
+	if len(key) == 0 {
+		return errors.New("transient data key must not be empty")
+	}
+	if !utf8.ValidString(key) {
+		return fmt.Errorf("transient data key is not valid UTF-8: %q", key)
+	}
+	if strings.ContainsRune(key, 0) {
+		return fmt.Errorf("transient data key contains a null character: %q", key)
+	}
+
+	return nil
+}
+
 // WithEndorsingOrganizations specifies the organizations that should endorse the transaction proposal.
 // No other organizations will be sent the proposal.  This is usually used in combination with WithTransient
 // for private data scenarios, or for state-based endorsement when specific organizations have to endorse the proposal.
@@ -202,3 +303,21 @@ func WithEndorsingOrganizations(mspids ...string) ProposalOption {
 		return nil
 	}
 }
+
+// WithNonce uses the supplied nonce for the transaction proposal instead of a randomly generated value. The transaction
+// ID is derived from the nonce and the client identity, so the nonce must be unique for each transaction proposal.
+func WithNonce(nonce []byte) ProposalOption {
+	return func(builder *proposalBuilder) error {
+		if len(nonce) == 0 {
+			return errors.New("nonce must not be empty")
+		}
+
+		transactionCtx, err := newTransactionContextWithNonce(builder.signingID, nonce)
+		if err != nil {
+			return err
+		}
+
+		builder.transactionCtx = transactionCtx
+		return nil
+	}
+}
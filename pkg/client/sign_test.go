@@ -8,9 +8,12 @@ package client
 
 import (
 	"context"
+	"crypto/ed25519"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hyperledger/fabric-gateway/pkg/hash"
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
 	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
 	"github.com/stretchr/testify/require"
@@ -102,6 +105,40 @@ func TestSign(t *testing.T) {
 		require.EqualValues(t, expected, actual)
 	})
 
+	t.Run("Evaluate signs full proposal using Ed25519 private key with no hash", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+
+		sign, err := identity.NewPrivateKeySign(privateKey)
+		require.NoError(t, err)
+
+		var actual *peer.SignedProposal
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, in *gateway.EvaluateRequest, _ ...grpc.CallOption) {
+				actual = in.ProposedTransaction
+			}).
+			Return(evaluateResponse, nil).
+			Times(1)
+		mockDeliver := NewMockDeliverClient(gomock.NewController(t))
+
+		gw, err := Connect(
+			TestCredentials.Identity(),
+			WithSign(sign),
+			WithHash(hash.NONE),
+			WithGatewayClient(mockClient),
+			WithDeliverClient(mockDeliver),
+		)
+		require.NoError(t, err)
+		defer gw.Close()
+
+		_, err = gw.GetNetwork("network").GetContract("chaincode").Evaluate("transaction")
+		require.NoError(t, err)
+
+		isValid := ed25519.Verify(publicKey, actual.ProposalBytes, actual.Signature)
+		require.True(t, isValid, "valid signature")
+	})
+
 	t.Run("Default error implementation is used if no signing implementation supplied", func(t *testing.T) {
 		mockClient := NewMockGatewayClient(gomock.NewController(t))
 		mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
@@ -8,6 +8,8 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -86,6 +88,62 @@ func TestSubmitTransaction(t *testing.T) {
 		var actual *EndorseError
 		require.ErrorAsf(t, err, &actual, "error type: %T", err)
 		require.Equal(t, proposal.TransactionID(), actual.TransactionID, "transaction ID")
+		require.Equal(t, "network", actual.ChannelID, "channel ID")
+	})
+
+	t.Run("Returns endorse error details", func(t *testing.T) {
+		expected := []*gateway.ErrorDetail{
+			{
+				Address: "peer1.org1.example.com:7051",
+				MspId:   "Org1MSP",
+				Message: "CHAINCODE_ERROR_1",
+			},
+			{
+				Address: "peer1.org2.example.com:9051",
+				MspId:   "Org2MSP",
+				Message: "CHAINCODE_ERROR_2",
+			},
+		}
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Endorse(gomock.Any(), gomock.Any()).
+			Return(nil, NewStatusError(t, codes.Aborted, "ENDORSE_ERROR", expected[0], expected[1]))
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var endorseErr *EndorseError
+		require.ErrorAsf(t, err, &endorseErr, "error type: %T", err)
+		actual := endorseErr.Details()
+		require.Len(t, actual, len(expected))
+		for i := range expected {
+			test.AssertProtoEqual(t, expected[i], actual[i])
+		}
+	})
+
+	t.Run("EndorseErrorDetails returns details of wrapped endorse error", func(t *testing.T) {
+		expected := &gateway.ErrorDetail{
+			Address: "peer1.org1.example.com:7051",
+			MspId:   "Org1MSP",
+			Message: "CHAINCODE_ERROR",
+		}
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Endorse(gomock.Any(), gomock.Any()).
+			Return(nil, NewStatusError(t, codes.Aborted, "ENDORSE_ERROR", expected))
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		actual := EndorseErrorDetails(fmt.Errorf("wrapped: %w", err))
+		require.Len(t, actual, 1)
+		test.AssertProtoEqual(t, expected, actual[0])
+	})
+
+	t.Run("EndorseErrorDetails returns nil for other errors", func(t *testing.T) {
+		actual := EndorseErrorDetails(errors.New("MOCK_ERROR"))
+
+		require.Nil(t, actual)
 	})
 
 	t.Run("Returns submit error", func(t *testing.T) {
@@ -111,6 +169,29 @@ func TestSubmitTransaction(t *testing.T) {
 		require.Equal(t, proposal.TransactionID(), actual.TransactionID, "transaction ID")
 	})
 
+	t.Run("Returns submit error details", func(t *testing.T) {
+		expected := &gateway.ErrorDetail{
+			Address: "orderer1.example.com:7050",
+			MspId:   "OrdererMSP",
+			Message: "SUBMIT_ERROR",
+		}
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Endorse(gomock.Any(), gomock.Any()).
+			Return(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"), nil)
+		mockClient.EXPECT().Submit(gomock.Any(), gomock.Any()).
+			Return(nil, NewStatusError(t, codes.Aborted, "SUBMIT_ERROR", expected))
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var actual *SubmitError
+		require.ErrorAsf(t, err, &actual, "error type: %T", err)
+		details := actual.Details()
+		require.Len(t, details, 1)
+		test.AssertProtoEqual(t, expected, details[0])
+	})
+
 	t.Run("Returns commit status error", func(t *testing.T) {
 		expected := NewStatusError(t, codes.Aborted, "COMMIT_ERROR")
 		mockClient := NewMockGatewayClient(gomock.NewController(t))
@@ -138,6 +219,31 @@ func TestSubmitTransaction(t *testing.T) {
 		require.Equal(t, proposal.TransactionID(), actual.TransactionID, "transaction ID")
 	})
 
+	t.Run("Returns commit status error details", func(t *testing.T) {
+		expected := &gateway.ErrorDetail{
+			Address: "orderer1.example.com:7050",
+			MspId:   "OrdererMSP",
+			Message: "COMMIT_STATUS_ERROR",
+		}
+		mockClient := NewMockGatewayClient(gomock.NewController(t))
+		mockClient.EXPECT().Endorse(gomock.Any(), gomock.Any()).
+			Return(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"), nil)
+		mockClient.EXPECT().Submit(gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+		mockClient.EXPECT().CommitStatus(gomock.Any(), gomock.Any()).
+			Return(nil, NewStatusError(t, codes.Aborted, "COMMIT_STATUS_ERROR", expected))
+
+		contract := AssertNewTestContract(t, "chaincode", WithGatewayClient(mockClient))
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var actual *CommitStatusError
+		require.ErrorAsf(t, err, &actual, "error type: %T", err)
+		details := actual.Details()
+		require.Len(t, details, 1)
+		test.AssertProtoEqual(t, expected, details[0])
+	})
+
 	t.Run("Returns result for committed transaction", func(t *testing.T) {
 		expected := []byte("TRANSACTION_RESULT")
 		mockClient := NewMockGatewayClient(gomock.NewController(t))
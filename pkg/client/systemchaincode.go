@@ -0,0 +1,104 @@
+/*
+Copyright 2022 IBM All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	qsccName = "qscc"
+	csccName = "cscc"
+)
+
+// GetBlockByNumber obtains the block with the specified number from the ledger by evaluating the query system
+// chaincode (qscc) GetBlockByNumber transaction.
+func (network *Network) GetBlockByNumber(blockNumber uint64) (*common.Block, error) {
+	block := &common.Block{}
+	if err := network.evaluateQscc(block, "GetBlockByNumber", strconv.FormatUint(blockNumber, 10)); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// GetBlockByTxID obtains the block containing the specified transaction from the ledger by evaluating the query system
+// chaincode (qscc) GetBlockByTxID transaction.
+func (network *Network) GetBlockByTxID(transactionID string) (*common.Block, error) {
+	block := &common.Block{}
+	if err := network.evaluateQscc(block, "GetBlockByTxID", transactionID); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// GetTransactionByID obtains the specified transaction, along with its validation code, from the ledger by evaluating
+// the query system chaincode (qscc) GetTransactionByID transaction.
+func (network *Network) GetTransactionByID(transactionID string) (*peer.ProcessedTransaction, error) {
+	transaction := &peer.ProcessedTransaction{}
+	if err := network.evaluateQscc(transaction, "GetTransactionByID", transactionID); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// GetChannelConfig obtains the current channel configuration by evaluating the configuration system chaincode (cscc)
+// GetConfigBlock transaction and extracting the configuration from the returned config block.
+func (network *Network) GetChannelConfig() (*common.Config, error) {
+	block := &common.Block{}
+	if err := network.GetContract(csccName).EvaluateTransactionInto(block, "GetConfigBlock", network.name); err != nil {
+		return nil, err
+	}
+
+	return parseConfigFromBlock(block)
+}
+
+func parseConfigFromBlock(block *common.Block) (*common.Config, error) {
+	blockData := block.GetData().GetData()
+	if len(blockData) == 0 {
+		return nil, errors.New("config block contains no transactions")
+	}
+
+	envelope := &common.Envelope{}
+	if err := proto.Unmarshal(blockData[0], envelope); err != nil {
+		return nil, fmt.Errorf("failed to deserialize envelope: %w", err)
+	}
+
+	payload := &common.Payload{}
+	if err := proto.Unmarshal(envelope.GetPayload(), payload); err != nil {
+		return nil, fmt.Errorf("failed to deserialize payload: %w", err)
+	}
+
+	channelHeader := &common.ChannelHeader{}
+	if err := proto.Unmarshal(payload.GetHeader().GetChannelHeader(), channelHeader); err != nil {
+		return nil, fmt.Errorf("failed to deserialize channel header: %w", err)
+	}
+
+	if headerType := common.HeaderType(channelHeader.GetType()); headerType != common.HeaderType_CONFIG {
+		return nil, fmt.Errorf("block is not a config block, header type: %v", headerType)
+	}
+
+	configEnvelope := &common.ConfigEnvelope{}
+	if err := proto.Unmarshal(payload.GetData(), configEnvelope); err != nil {
+		return nil, fmt.Errorf("failed to deserialize config envelope: %w", err)
+	}
+
+	return configEnvelope.GetConfig(), nil
+}
+
+func (network *Network) evaluateQscc(result proto.Message, transactionName string, args ...string) error {
+	args = append([]string{network.name}, args...)
+	return network.GetContract(qsccName).EvaluateTransactionInto(result, transactionName, args...)
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2022 IBM All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hyperledger/fabric-gateway/pkg/internal/test"
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSystemChaincode(t *testing.T) {
+	newEvaluateResponse := func(t *testing.T, result proto.Message) *gateway.EvaluateResponse {
+		payload, err := proto.Marshal(result)
+		require.NoError(t, err)
+
+		return &gateway.EvaluateResponse{
+			Result: &peer.Response{
+				Payload: payload,
+			},
+		}
+	}
+
+	type TestCase struct {
+		description     string
+		run             func(network *Network) (proto.Message, error)
+		transactionName string
+		args            []string
+		result          proto.Message
+	}
+
+	testCases := []TestCase{
+		{
+			description: "GetBlockByNumber",
+			run: func(network *Network) (proto.Message, error) {
+				return network.GetBlockByNumber(101)
+			},
+			transactionName: "GetBlockByNumber",
+			args:            []string{"NETWORK", "101"},
+			result:          &common.Block{Header: &common.BlockHeader{Number: 101}},
+		},
+		{
+			description: "GetBlockByTxID",
+			run: func(network *Network) (proto.Message, error) {
+				return network.GetBlockByTxID("TX_ID")
+			},
+			transactionName: "GetBlockByTxID",
+			args:            []string{"NETWORK", "TX_ID"},
+			result:          &common.Block{Header: &common.BlockHeader{Number: 101}},
+		},
+		{
+			description: "GetTransactionByID",
+			run: func(network *Network) (proto.Message, error) {
+				return network.GetTransactionByID("TX_ID")
+			},
+			transactionName: "GetTransactionByID",
+			args:            []string{"NETWORK", "TX_ID"},
+			result:          &peer.ProcessedTransaction{ValidationCode: int32(peer.TxValidationCode_VALID)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Run("Evaluates qscc transaction with channel name and arguments", func(t *testing.T) {
+				var chaincodeName string
+				var args [][]byte
+				mockClient := NewMockGatewayClient(gomock.NewController(t))
+				mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+					Do(func(_ context.Context, in *gateway.EvaluateRequest, _ ...grpc.CallOption) {
+						spec := test.AssertUnmarshalInvocationSpec(t, in.ProposedTransaction).ChaincodeSpec
+						chaincodeName = spec.ChaincodeId.Name
+						args = spec.Input.Args
+					}).
+					Return(newEvaluateResponse(t, tc.result), nil).
+					Times(1)
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+
+				_, err := tc.run(network)
+				require.NoError(t, err)
+
+				require.Equal(t, "qscc", chaincodeName, "chaincode name")
+				require.Equal(t, tc.transactionName, string(args[0]), "transaction name")
+				require.EqualValues(t, tc.args, bytesAsStrings(args[1:]), "arguments")
+			})
+
+			t.Run("Returns unmarshalled result", func(t *testing.T) {
+				mockClient := NewMockGatewayClient(gomock.NewController(t))
+				mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+					Return(newEvaluateResponse(t, tc.result), nil)
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+
+				actual, err := tc.run(network)
+				require.NoError(t, err)
+
+				test.AssertProtoEqual(t, tc.result, actual)
+			})
+
+			t.Run("Returns evaluate error", func(t *testing.T) {
+				expected := NewStatusError(t, codes.NotFound, "EVALUATE_ERROR")
+				mockClient := NewMockGatewayClient(gomock.NewController(t))
+				mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+					Return(nil, expected)
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+
+				_, err := tc.run(network)
+
+				require.ErrorIs(t, err, expected)
+			})
+		})
+	}
+
+	t.Run("GetChannelConfig", func(t *testing.T) {
+		newBlock := func(t *testing.T, headerType common.HeaderType, config *common.Config) *common.Block {
+			configEnvelope, err := proto.Marshal(&common.ConfigEnvelope{Config: config})
+			require.NoError(t, err)
+
+			channelHeader, err := proto.Marshal(&common.ChannelHeader{Type: int32(headerType)})
+			require.NoError(t, err)
+
+			payload, err := proto.Marshal(&common.Payload{
+				Header: &common.Header{ChannelHeader: channelHeader},
+				Data:   configEnvelope,
+			})
+			require.NoError(t, err)
+
+			envelope, err := proto.Marshal(&common.Envelope{Payload: payload})
+			require.NoError(t, err)
+
+			return &common.Block{
+				Data: &common.BlockData{
+					Data: [][]byte{envelope},
+				},
+			}
+		}
+
+		newConfigBlock := func(t *testing.T, config *common.Config) *common.Block {
+			return newBlock(t, common.HeaderType_CONFIG, config)
+		}
+
+		t.Run("Evaluates cscc GetConfigBlock with channel name", func(t *testing.T) {
+			var chaincodeName string
+			var args [][]byte
+			mockClient := NewMockGatewayClient(gomock.NewController(t))
+			mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+				Do(func(_ context.Context, in *gateway.EvaluateRequest, _ ...grpc.CallOption) {
+					spec := test.AssertUnmarshalInvocationSpec(t, in.ProposedTransaction).ChaincodeSpec
+					chaincodeName = spec.ChaincodeId.Name
+					args = spec.Input.Args
+				}).
+				Return(newEvaluateResponse(t, newConfigBlock(t, &common.Config{})), nil).
+				Times(1)
+
+			network := AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+
+			_, err := network.GetChannelConfig()
+			require.NoError(t, err)
+
+			require.Equal(t, "cscc", chaincodeName, "chaincode name")
+			require.EqualValues(t, []string{"GetConfigBlock", "NETWORK"}, bytesAsStrings(args), "arguments")
+		})
+
+		t.Run("Returns config from config block", func(t *testing.T) {
+			expected := &common.Config{Sequence: 3}
+			mockClient := NewMockGatewayClient(gomock.NewController(t))
+			mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+				Return(newEvaluateResponse(t, newConfigBlock(t, expected)), nil)
+
+			network := AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+
+			actual, err := network.GetChannelConfig()
+			require.NoError(t, err)
+
+			test.AssertProtoEqual(t, expected, actual)
+		})
+
+		t.Run("Returns error for block with no transactions", func(t *testing.T) {
+			mockClient := NewMockGatewayClient(gomock.NewController(t))
+			mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+				Return(newEvaluateResponse(t, &common.Block{}), nil)
+
+			network := AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+
+			_, err := network.GetChannelConfig()
+
+			require.Error(t, err)
+		})
+
+		t.Run("Returns error for block that is not a config block", func(t *testing.T) {
+			mockClient := NewMockGatewayClient(gomock.NewController(t))
+			mockClient.EXPECT().Evaluate(gomock.Any(), gomock.Any()).
+				Return(newEvaluateResponse(t, newBlock(t, common.HeaderType_ENDORSER_TRANSACTION, &common.Config{})), nil)
+
+			network := AssertNewTestNetwork(t, "NETWORK", WithGatewayClient(mockClient))
+
+			_, err := network.GetChannelConfig()
+
+			require.ErrorContains(t, err, "ENDORSER_TRANSACTION")
+		})
+	})
+}
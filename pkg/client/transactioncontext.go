@@ -25,12 +25,16 @@ func newTransactionContext(signingIdentity *signingIdentity) (*transactionContex
 		return nil, err
 	}
 
+	return newTransactionContextWithNonce(signingIdentity, nonce)
+}
+
+func newTransactionContextWithNonce(signingIdentity *signingIdentity, nonce []byte) (*transactionContext, error) {
 	creator, err := signingIdentity.Creator()
 	if err != nil {
 		return nil, err
 	}
 
-	saltedCreator := append(nonce, creator...)
+	saltedCreator := append(append([]byte{}, nonce...), creator...)
 	rawTransactionID := hash.SHA256(saltedCreator)
 	transactionID := hex.EncodeToString(rawTransactionID)
 
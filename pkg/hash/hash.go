@@ -9,13 +9,27 @@ package hash
 
 import (
 	"crypto/sha256"
+	"crypto/sha512"
 )
 
 // Hash function generates a digest for the supplied message.
 type Hash = func(message []byte) []byte
 
+// NONE returns the input message unchanged. This can be used if the signing implementation requires the full message
+// bytes, not just a pre-generated digest, such as Ed25519 signing or a signing service that hashes the message itself.
+// It must not be used with ECDSA signing implementations, which require a pre-generated digest.
+func NONE(message []byte) []byte {
+	return message
+}
+
 // SHA256 hash the supplied message bytes to create a digest for signing.
 func SHA256(message []byte) []byte {
 	digest := sha256.Sum256(message)
 	return digest[:]
 }
+
+// SHA384 hash the supplied message bytes to create a digest for signing.
+func SHA384(message []byte) []byte {
+	digest := sha512.Sum384(message)
+	return digest[:]
+}
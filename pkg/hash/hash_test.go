@@ -33,4 +33,39 @@ func TestHash(t *testing.T) {
 			require.NotEqualValues(t, fooHash, barHash)
 		})
 	})
+
+	t.Run("SHA384", func(t *testing.T) {
+		t.Run("Hashes of identical data are identical", func(t *testing.T) {
+			message := []byte("foobar")
+
+			hash1 := SHA384(message)
+			hash2 := SHA384(message)
+
+			require.EqualValues(t, hash1, hash2)
+		})
+
+		t.Run("Hashes of different data are not identical", func(t *testing.T) {
+			foo := []byte("foo")
+			bar := []byte("bar")
+
+			fooHash := SHA384(foo)
+			barHash := SHA384(bar)
+
+			require.NotEqualValues(t, fooHash, barHash)
+		})
+
+		t.Run("Digest is 48 bytes", func(t *testing.T) {
+			digest := SHA384([]byte("foobar"))
+
+			require.Len(t, digest, 48)
+		})
+	})
+
+	t.Run("NONE returns message unchanged", func(t *testing.T) {
+		message := []byte("foobar")
+
+		actual := NONE(message)
+
+		require.EqualValues(t, message, actual)
+	})
 }
@@ -9,14 +9,24 @@ package identity
 import (
 	"crypto/ecdsa"
 	"crypto/rand"
+	"crypto/sha512"
 	"encoding/asn1"
+	"fmt"
 	"math/big"
 )
 
+// maxDigestSize is the size of the largest digest that can be signed. ECDSA signs only as many leading bytes of its
+// input as fit the curve order, so a longer input is assumed to be a message that has not been hashed.
+const maxDigestSize = sha512.Size
+
 func ecdsaPrivateKeySign(privateKey *ecdsa.PrivateKey) Sign {
 	n := privateKey.Params().Params().N
 
 	return func(digest []byte) ([]byte, error) {
+		if len(digest) > maxDigestSize {
+			return nil, fmt.Errorf("digest length %d exceeds maximum of %d bytes", len(digest), maxDigestSize)
+		}
+
 		r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest)
 		if err != nil {
 			return nil, err
@@ -9,18 +9,43 @@ package identity
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"fmt"
+
+	"github.com/hyperledger/fabric-gateway/pkg/hash"
 )
 
 // Sign function generates a digital signature of the supplied digest.
 type Sign = func(digest []byte) ([]byte, error)
 
 // NewPrivateKeySign returns a Sign function that uses the supplied private key.
+//
+// Ed25519 signatures are generated from the full message rather than a pre-generated digest, so Ed25519 private keys
+// must be used in combination with the hash.NONE hash implementation. Use NewPrivateKeySignAndHash to obtain the
+// matching hash implementation along with the Sign function.
 func NewPrivateKeySign(privateKey crypto.PrivateKey) (Sign, error) {
 	switch key := privateKey.(type) {
 	case *ecdsa.PrivateKey:
 		return ecdsaPrivateKeySign(key), nil
+	case ed25519.PrivateKey:
+		return ed25519PrivateKeySign(key), nil
 	default:
 		return nil, fmt.Errorf("unsupported key type: %T", privateKey)
 	}
 }
+
+// NewPrivateKeySignAndHash returns a Sign function that uses the supplied private key, along with the hash
+// implementation that must be used to generate the digests it signs. This is hash.SHA256 for ECDSA private keys and
+// hash.NONE for Ed25519 private keys.
+func NewPrivateKeySignAndHash(privateKey crypto.PrivateKey) (Sign, hash.Hash, error) {
+	sign, err := NewPrivateKeySign(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := privateKey.(ed25519.PrivateKey); ok {
+		return sign, hash.NONE, nil
+	}
+
+	return sign, hash.SHA256, nil
+}
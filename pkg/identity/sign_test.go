@@ -9,6 +9,8 @@ package identity
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
 	"encoding/asn1"
 	"fmt"
 	"math/big"
@@ -46,6 +48,69 @@ func TestSigner(t *testing.T) {
 		require.True(t, isValid, "valid signature")
 	})
 
+	t.Run("Create signer with Ed25519 private key", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+
+		message := []byte("MESSAGE")
+
+		sign, err := NewPrivateKeySign(privateKey)
+		require.NoError(t, err)
+
+		signature, err := sign(message)
+		require.NoError(t, err, "sign")
+
+		isValid := ed25519.Verify(publicKey, message, signature)
+		require.True(t, isValid, "valid signature")
+	})
+
+	t.Run("ECDSA signer rejects input longer than a digest", func(t *testing.T) {
+		sign, err := NewPrivateKeySign(privateKey)
+		require.NoError(t, err)
+
+		message := make([]byte, 65)
+		rand.Read(message)
+
+		_, err = sign(message)
+		require.Error(t, err)
+	})
+
+	t.Run("Create signer and hash with ECDSA private key", func(t *testing.T) {
+		message := []byte("MESSAGE")
+
+		sign, hash, err := NewPrivateKeySignAndHash(privateKey)
+		require.NoError(t, err)
+
+		signature, err := sign(hash(message))
+		require.NoError(t, err, "sign")
+
+		digest := sha256.Sum256(message)
+		isValid := ecdsa.VerifyASN1(&privateKey.PublicKey, digest[:], signature)
+		require.True(t, isValid, "valid signature")
+	})
+
+	t.Run("Create signer and hash with Ed25519 private key", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+
+		message := []byte("MESSAGE")
+
+		sign, hash, err := NewPrivateKeySignAndHash(privateKey)
+		require.NoError(t, err)
+
+		signature, err := sign(hash(message))
+		require.NoError(t, err, "sign")
+
+		isValid := ed25519.Verify(publicKey, message, signature)
+		require.True(t, isValid, "valid signature")
+	})
+
+	t.Run("Create signer and hash with unsupported private key type fails", func(t *testing.T) {
+		var privateKey crypto.PrivateKey
+		_, _, err := NewPrivateKeySignAndHash(privateKey)
+		require.Error(t, err)
+	})
+
 	t.Run("ECDSA signatures are canonical", func(t *testing.T) {
 		sign, err := NewPrivateKeySign(privateKey)
 		require.NoError(t, err)